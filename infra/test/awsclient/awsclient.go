@@ -0,0 +1,294 @@
+// Package awsclient provides small, testing-scoped helpers for exercising
+// the data plane of the agent-mesh modules (DynamoDB, S3, EventBridge) once
+// Terratest has applied them. It intentionally wraps only the handful of
+// SDK calls the integration tests need rather than being a general client.
+package awsclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultRegion is used when a test does not pin a region explicitly.
+const DefaultRegion = "us-east-1"
+
+// NewConfig loads an SDK config pinned to region, failing the test on error.
+func NewConfig(t *testing.T, region string) aws.Config {
+	t.Helper()
+
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(region))
+	require.NoError(t, err, "loading AWS SDK config for region %s", region)
+	return cfg
+}
+
+// DynamoDB returns a DynamoDB client for region.
+func DynamoDB(t *testing.T, region string) *dynamodb.Client {
+	t.Helper()
+	return dynamodb.NewFromConfig(NewConfig(t, region))
+}
+
+// S3 returns an S3 client for region.
+func S3(t *testing.T, region string) *s3.Client {
+	t.Helper()
+	return s3.NewFromConfig(NewConfig(t, region))
+}
+
+// EventBridge returns an EventBridge client for region.
+func EventBridge(t *testing.T, region string) *eventbridge.Client {
+	t.Helper()
+	return eventbridge.NewFromConfig(NewConfig(t, region))
+}
+
+// SQS returns an SQS client for region.
+func SQS(t *testing.T, region string) *sqs.Client {
+	t.Helper()
+	return sqs.NewFromConfig(NewConfig(t, region))
+}
+
+// PutItemWithTTL writes key/value into table with a ttlAttr expiring after
+// ttl. It does not read the item back; use GetItem for the round-trip check.
+func PutItemWithTTL(t *testing.T, client *dynamodb.Client, table, ttlAttr, key, value string, ttl time.Duration) {
+	t.Helper()
+
+	item := map[string]ddbtypes.AttributeValue{
+		"id":    &ddbtypes.AttributeValueMemberS{Value: key},
+		"value": &ddbtypes.AttributeValueMemberS{Value: value},
+		ttlAttr: &ddbtypes.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)},
+	}
+
+	_, err := client.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item:      item,
+	})
+	require.NoError(t, err, "PutItem against %s", table)
+}
+
+// GetItem fetches key from table and returns its raw attribute map.
+func GetItem(t *testing.T, client *dynamodb.Client, table, key string) map[string]ddbtypes.AttributeValue {
+	t.Helper()
+
+	out, err := client.GetItem(context.Background(), &dynamodb.GetItemInput{
+		TableName: aws.String(table),
+		Key: map[string]ddbtypes.AttributeValue{
+			"id": &ddbtypes.AttributeValueMemberS{Value: key},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	require.NoError(t, err, "GetItem against %s", table)
+	return out.Item
+}
+
+// DeleteItem removes key from table, used to clean up after an assertion.
+func DeleteItem(t *testing.T, client *dynamodb.Client, table, key string) {
+	t.Helper()
+
+	_, err := client.DeleteItem(context.Background(), &dynamodb.DeleteItemInput{
+		TableName: aws.String(table),
+		Key: map[string]ddbtypes.AttributeValue{
+			"id": &ddbtypes.AttributeValueMemberS{Value: key},
+		},
+	})
+	require.NoError(t, err, "DeleteItem against %s", table)
+}
+
+// PutObject uploads body to bucket/key with SSE requested via sseAlgorithm
+// (e.g. "aws:kms" or "AES256").
+func PutObject(t *testing.T, client *s3.Client, bucket, key, sseAlgorithm string, body []byte) {
+	t.Helper()
+
+	_, err := client.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(key),
+		Body:                 bytes.NewReader(body),
+		ServerSideEncryption: s3types.ServerSideEncryption(sseAlgorithm),
+	})
+	require.NoError(t, err, "PutObject to %s/%s", bucket, key)
+}
+
+// GetObjectAndAssertSSE downloads bucket/key, asserts it was served with the
+// expected SSE algorithm, and returns its body.
+func GetObjectAndAssertSSE(t *testing.T, client *s3.Client, bucket, key, wantSSEAlgorithm string) []byte {
+	t.Helper()
+
+	out, err := client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	require.NoError(t, err, "GetObject for %s/%s", bucket, key)
+	defer out.Body.Close()
+
+	require.Equal(t, wantSSEAlgorithm, string(out.ServerSideEncryption), "unexpected SSE algorithm on %s/%s", bucket, key)
+
+	body, err := io.ReadAll(out.Body)
+	require.NoError(t, err, "reading body for %s/%s", bucket, key)
+	return body
+}
+
+// DeleteObject removes bucket/key, used to clean up after an assertion.
+func DeleteObject(t *testing.T, client *s3.Client, bucket, key string) {
+	t.Helper()
+
+	_, err := client.DeleteObject(context.Background(), &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	require.NoError(t, err, "DeleteObject for %s/%s", bucket, key)
+}
+
+// PutEvent puts a single event onto busArn without provisioning any rule or
+// target of its own; use this when the caller's fixture already wires the
+// routing and only the PutEvents call needs exercising.
+func PutEvent(t *testing.T, client *eventbridge.Client, busArn, source, detailType, detail string) {
+	t.Helper()
+
+	_, err := client.PutEvents(context.Background(), &eventbridge.PutEventsInput{
+		Entries: []ebtypes.PutEventsRequestEntry{
+			{
+				EventBusName: aws.String(busArn),
+				Source:       aws.String(source),
+				DetailType:   aws.String(detailType),
+				Detail:       aws.String(detail),
+			},
+		},
+	})
+	require.NoError(t, err, "putting event onto %s", busArn)
+}
+
+// GetObjectIfExists downloads bucket/key and returns an error instead of
+// failing the test, so callers can poll for an eventually-consistent write
+// (e.g. via retry.DoWithRetry) without terminating the test on each miss.
+func GetObjectIfExists(t *testing.T, client *s3.Client, bucket, key string) ([]byte, error) {
+	t.Helper()
+
+	out, err := client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	return io.ReadAll(out.Body)
+}
+
+// AwaitEventDelivery puts a test event onto busArn matching source/detailType,
+// routed via a temporary rule to a temporary SQS target, and polls the queue
+// until the event is received or timeout elapses. The rule, target, and
+// queue are torn down before returning regardless of outcome.
+func AwaitEventDelivery(t *testing.T, ebClient *eventbridge.Client, sqsClient *sqs.Client, busArn, busName, source, detailType, detail string, timeout time.Duration) []byte {
+	t.Helper()
+	ctx := context.Background()
+
+	queueName := ruleName(busName) + "-target"
+	createQueueOut, err := sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String(queueName),
+	})
+	require.NoError(t, err, "creating temporary SQS target queue")
+	defer func() {
+		_, _ = sqsClient.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: createQueueOut.QueueUrl})
+	}()
+
+	queueArnOut, err := sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       createQueueOut.QueueUrl,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn},
+	})
+	require.NoError(t, err, "reading ARN of temporary SQS target queue")
+	queueArn := queueArnOut.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
+
+	rule := ruleName(busName)
+	putRuleOut, err := ebClient.PutRule(ctx, &eventbridge.PutRuleInput{
+		Name:         aws.String(rule),
+		EventBusName: aws.String(busName),
+		EventPattern: aws.String(`{"source":["` + source + `"],"detail-type":["` + detailType + `"]}`),
+	})
+	require.NoError(t, err, "creating temporary rule on %s", busName)
+	defer func() {
+		_, _ = ebClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{Name: aws.String(rule), EventBusName: aws.String(busName)})
+	}()
+
+	// EventBridge won't deliver to the queue without this, even within the
+	// same account: the target attachment alone doesn't grant the rule
+	// permission to call sqs:SendMessage, so PutTargets below would succeed
+	// while delivery silently failed.
+	queuePolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"` + queueArn + `","Condition":{"ArnEquals":{"aws:SourceArn":"` + *putRuleOut.RuleArn + `"}}}]}`
+	_, err = sqsClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl: createQueueOut.QueueUrl,
+		Attributes: map[string]string{
+			string(sqstypes.QueueAttributeNamePolicy): queuePolicy,
+		},
+	})
+	require.NoError(t, err, "granting %s sqs:SendMessage on temporary target queue", rule)
+
+	_, err = ebClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule:         aws.String(rule),
+		EventBusName: aws.String(busName),
+		Targets: []ebtypes.Target{
+			{Id: aws.String("sweep-target"), Arn: aws.String(queueArn)},
+		},
+	})
+	require.NoError(t, err, "attaching temporary SQS target to rule %s", rule)
+	defer func() {
+		_, _ = ebClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
+			Rule:         aws.String(rule),
+			EventBusName: aws.String(busName),
+			Ids:          []string{"sweep-target"},
+		})
+	}()
+
+	_, err = ebClient.PutEvents(ctx, &eventbridge.PutEventsInput{
+		Entries: []ebtypes.PutEventsRequestEntry{
+			{
+				EventBusName: aws.String(busArn),
+				Source:       aws.String(source),
+				DetailType:   aws.String(detailType),
+				Detail:       aws.String(detail),
+			},
+		},
+	})
+	require.NoError(t, err, "putting test event onto %s", busArn)
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		out, err := sqsClient.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            createQueueOut.QueueUrl,
+			WaitTimeSeconds:     5,
+			MaxNumberOfMessages: 1,
+		})
+		require.NoError(t, err, "polling temporary SQS target queue")
+		if len(out.Messages) > 0 {
+			_, _ = sqsClient.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+				QueueUrl:      createQueueOut.QueueUrl,
+				ReceiptHandle: out.Messages[0].ReceiptHandle,
+			})
+			return []byte(aws.ToString(out.Messages[0].Body))
+		}
+	}
+
+	require.FailNow(t, "timed out waiting for event delivery", "bus=%s source=%s detailType=%s", busName, source, detailType)
+	return nil
+}
+
+func ruleName(busName string) string {
+	return busName + "-sweep-probe"
+}
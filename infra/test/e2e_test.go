@@ -0,0 +1,72 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/retry"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/awsclient"
+)
+
+// TestAgentMeshE2E composes all three modules plus a relay Lambda in
+// fixtures/e2e and confirms the mesh works together: an EventBridge rule
+// invokes the relay, which writes to the KV table and drops an artifact
+// into the bucket. Per-module tests can't see this class of regression —
+// IAM gaps between the bus and downstream targets, bucket policy
+// conflicts — so this is the reproducible whole-platform smoke test.
+func TestAgentMeshE2E(t *testing.T) {
+	t.Parallel()
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "fixtures/e2e",
+		Vars: map[string]interface{}{
+			"env": "e2e",
+		},
+		NoColor: true,
+	})
+
+	defer terraform.Destroy(t, terraformOptions)
+	terraform.InitAndApply(t, terraformOptions)
+
+	tableName := terraform.Output(t, terraformOptions, "table_name")
+	bucketName := terraform.Output(t, terraformOptions, "bucket_name")
+	busArn := terraform.Output(t, terraformOptions, "bus_arn")
+
+	region := awsclient.DefaultRegion
+	ebClient := awsclient.EventBridge(t, region)
+	ddb := awsclient.DynamoDB(t, region)
+	s3Client := awsclient.S3(t, region)
+
+	probeID := fmt.Sprintf("e2e-%d", time.Now().UnixNano())
+	artifactKey := "e2e/" + probeID + ".json"
+
+	awsclient.PutEvent(t, ebClient, busArn, "agent-mesh.e2e", "E2EProbe", fmt.Sprintf(`{"probe_id":%q}`, probeID))
+
+	_, err := retry.DoWithRetryE(t, "waiting for relay to write KV item", 10, 3*time.Second, func() (string, error) {
+		item := awsclient.GetItem(t, ddb, tableName, probeID)
+		if len(item) == 0 {
+			return "", fmt.Errorf("KV item %s not yet written", probeID)
+		}
+		return "kv item present", nil
+	})
+	assert.NoError(t, err, "relay never wrote %s into %s", probeID, tableName)
+	defer awsclient.DeleteItem(t, ddb, tableName, probeID)
+
+	var artifactBody []byte
+	_, err = retry.DoWithRetryE(t, "waiting for relay to drop artifact", 10, 3*time.Second, func() (string, error) {
+		body, getErr := awsclient.GetObjectIfExists(t, s3Client, bucketName, artifactKey)
+		if getErr != nil {
+			return "", getErr
+		}
+		artifactBody = body
+		return "artifact present", nil
+	})
+	assert.NoError(t, err, "relay never wrote %s into %s", artifactKey, bucketName)
+	defer awsclient.DeleteObject(t, s3Client, bucketName, artifactKey)
+
+	assert.Contains(t, string(artifactBody), probeID)
+}
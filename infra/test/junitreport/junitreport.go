@@ -0,0 +1,77 @@
+// Package junitreport writes minimal JUnit-style XML summaries so CI can
+// ingest per-scenario Terratest results without a separate reporting tool.
+package junitreport
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Failure describes why a test case failed.
+type Failure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// TestCase is a single JUnit <testcase>.
+type TestCase struct {
+	Name      string   `xml:"name,attr"`
+	Classname string   `xml:"classname,attr"`
+	Time      float64  `xml:"time,attr"`
+	Failure   *Failure `xml:"failure,omitempty"`
+}
+
+type testSuite struct {
+	XMLName  xml.Name   `xml:"testsuite"`
+	Name     string     `xml:"name,attr"`
+	Tests    int        `xml:"tests,attr"`
+	Failures int        `xml:"failures,attr"`
+	Time     float64    `xml:"time,attr"`
+	Cases    []TestCase `xml:"testcase"`
+}
+
+// WriteCase writes a single-case JUnit suite file to dir, named after
+// classname/name, and returns the path written.
+func WriteCase(dir string, c TestCase) (string, error) {
+	failures := 0
+	if c.Failure != nil {
+		failures = 1
+	}
+
+	suite := testSuite{
+		Name:     c.Classname,
+		Tests:    1,
+		Failures: failures,
+		Time:     c.Time,
+		Cases:    []TestCase{c},
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, sanitize(c.Classname+"-"+c.Name)+".xml")
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(xml.Header); err != nil {
+		return "", err
+	}
+
+	enc := xml.NewEncoder(f)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func sanitize(name string) string {
+	replacer := strings.NewReplacer("/", "-", " ", "_")
+	return replacer.Replace(name)
+}
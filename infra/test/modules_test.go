@@ -1,74 +1,215 @@
 package test
 
 import (
+	"path/filepath"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/aquasecurity/trivy/pkg/iac/severity"
 	"github.com/gruntwork-io/terratest/modules/terraform"
 	"github.com/stretchr/testify/assert"
-)
 
-func TestDynamoDBModule(t *testing.T) {
-	t.Parallel()
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/awsclient"
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/junitreport"
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/staticscan"
+)
 
-	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
-		TerraformDir: "../modules/dynamodb_kv",
-		Vars: map[string]interface{}{
-			"env": "test",
-		},
-		NoColor: true,
-	})
+// junitReportDir is where per-matrix-cell JUnit summaries land for CI to
+// pick up; it's relative to this package so `go test ./...` and CI agree.
+const junitReportDir = "reports/junit"
+
+// matrixCase is one cell of the module x env x region matrix.
+type matrixCase struct {
+	name         string // scenario key, e.g. "dynamodb_kv/staging/us-west-2"
+	module       string
+	env          string
+	region       string
+	varOverrides map[string]interface{}
+	nameOutput   string
+	namePattern  *regexp.Regexp
+	arnOutput    string
+	arnPattern   *regexp.Regexp
+	verify       func(t *testing.T, region string, outputs map[string]string)
+}
 
-	defer terraform.Destroy(t, terraformOptions)
-	terraform.InitAndApply(t, terraformOptions)
+func namePattern(env, suffix string) *regexp.Regexp {
+	if suffix == "" {
+		return regexp.MustCompile(`^agent-mesh-` + env)
+	}
+	return regexp.MustCompile(`^agent-mesh-` + env + `-` + suffix)
+}
 
-	// Test outputs
-	tableName := terraform.Output(t, terraformOptions, "table_name")
-	assert.Contains(t, tableName, "agent-mesh-test-kv")
+func arnPattern(service, region string) *regexp.Regexp {
+	return regexp.MustCompile(`^arn:aws:` + service + `:` + region + `:`)
+}
 
-	tableArn := terraform.Output(t, terraformOptions, "table_arn")
-	assert.Contains(t, tableArn, "arn:aws:dynamodb")
+var matrix = []matrixCase{
+	{
+		name: "dynamodb_kv/test/us-east-1", module: "dynamodb_kv", env: "test", region: "us-east-1",
+		nameOutput: "table_name", namePattern: namePattern("test", "kv"),
+		arnOutput: "table_arn", arnPattern: arnPattern("dynamodb", "us-east-1"),
+		verify: verifyDynamoDB,
+	},
+	{
+		name: "dynamodb_kv/staging/us-west-2", module: "dynamodb_kv", env: "staging", region: "us-west-2",
+		varOverrides: map[string]interface{}{"region": "us-west-2"},
+		nameOutput:   "table_name", namePattern: namePattern("staging", "kv"),
+		arnOutput: "table_arn", arnPattern: arnPattern("dynamodb", "us-west-2"),
+		verify: verifyDynamoDB,
+	},
+	{
+		name: "dynamodb_kv/preprod/eu-west-1", module: "dynamodb_kv", env: "preprod", region: "eu-west-1",
+		varOverrides: map[string]interface{}{"region": "eu-west-1"},
+		nameOutput:   "table_name", namePattern: namePattern("preprod", "kv"),
+		arnOutput: "table_arn", arnPattern: arnPattern("dynamodb", "eu-west-1"),
+		verify: verifyDynamoDB,
+	},
+	{
+		name: "s3_bucket_artifacts/test/us-east-1", module: "s3_bucket_artifacts", env: "test", region: "us-east-1",
+		nameOutput: "bucket_name", namePattern: namePattern("test", "artifacts"),
+		arnOutput: "bucket_arn", arnPattern: regexp.MustCompile(`^arn:aws:s3:::`),
+		verify: verifyS3,
+	},
+	{
+		name: "s3_bucket_artifacts/staging/us-west-2", module: "s3_bucket_artifacts", env: "staging", region: "us-west-2",
+		varOverrides: map[string]interface{}{"region": "us-west-2"},
+		nameOutput:   "bucket_name", namePattern: namePattern("staging", "artifacts"),
+		arnOutput: "bucket_arn", arnPattern: regexp.MustCompile(`^arn:aws:s3:::`),
+		verify: verifyS3,
+	},
+	{
+		name: "s3_bucket_artifacts/preprod/eu-west-1", module: "s3_bucket_artifacts", env: "preprod", region: "eu-west-1",
+		varOverrides: map[string]interface{}{"region": "eu-west-1"},
+		nameOutput:   "bucket_name", namePattern: namePattern("preprod", "artifacts"),
+		arnOutput: "bucket_arn", arnPattern: regexp.MustCompile(`^arn:aws:s3:::`),
+		verify: verifyS3,
+	},
+	{
+		name: "eventbridge_bus/test/us-east-1", module: "eventbridge_bus", env: "test", region: "us-east-1",
+		nameOutput: "bus_name", namePattern: namePattern("test", ""),
+		arnOutput: "bus_arn", arnPattern: arnPattern("events", "us-east-1"),
+		verify: verifyEventBridge,
+	},
+	{
+		name: "eventbridge_bus/staging/us-west-2", module: "eventbridge_bus", env: "staging", region: "us-west-2",
+		varOverrides: map[string]interface{}{"region": "us-west-2"},
+		nameOutput:   "bus_name", namePattern: namePattern("staging", ""),
+		arnOutput: "bus_arn", arnPattern: arnPattern("events", "us-west-2"),
+		verify: verifyEventBridge,
+	},
+	{
+		name: "eventbridge_bus/preprod/eu-west-1", module: "eventbridge_bus", env: "preprod", region: "eu-west-1",
+		varOverrides: map[string]interface{}{"region": "eu-west-1"},
+		nameOutput:   "bus_name", namePattern: namePattern("preprod", ""),
+		arnOutput: "bus_arn", arnPattern: arnPattern("events", "eu-west-1"),
+		verify: verifyEventBridge,
+	},
 }
 
-func TestS3Module(t *testing.T) {
+// TestModulesMatrix runs every module against every env/region combination
+// in matrix, in parallel, asserting naming conventions by regex (rather
+// than hard-coded prefixes) so the matrix also catches name_prefix
+// regressions across regions.
+func TestModulesMatrix(t *testing.T) {
 	t.Parallel()
 
+	for _, tc := range matrix {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			runMatrixCase(t, tc)
+		})
+	}
+}
+
+func runMatrixCase(t *testing.T, tc matrixCase) {
+	t.Helper()
+	start := time.Now()
+
+	defer func() {
+		jc := junitreport.TestCase{
+			Name:      tc.name,
+			Classname: "modules_matrix",
+			Time:      time.Since(start).Seconds(),
+		}
+		if t.Failed() {
+			jc.Failure = &junitreport.Failure{Message: "matrix case failed", Text: tc.name}
+		}
+		if _, err := junitreport.WriteCase(junitReportDir, jc); err != nil {
+			t.Logf("writing junit summary for %s: %v", tc.name, err)
+		}
+	}()
+
+	vars := map[string]interface{}{"env": tc.env}
+	for k, v := range tc.varOverrides {
+		vars[k] = v
+	}
+
 	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
-		TerraformDir: "../modules/s3_bucket_artifacts",
-		Vars: map[string]interface{}{
-			"env": "test",
-		},
-		NoColor: true,
+		TerraformDir: "../modules/" + tc.module,
+		Vars:         vars,
+		NoColor:      true,
+	})
+
+	staticscan.Run(t, terraformOptions.TerraformDir, staticscan.Options{
+		MinFailSeverity: severity.High,
+		AllowlistPath:   filepath.Join(terraformOptions.TerraformDir, ".trivyignore"),
 	})
 
 	defer terraform.Destroy(t, terraformOptions)
 	terraform.InitAndApply(t, terraformOptions)
 
-	// Test outputs
-	bucketName := terraform.Output(t, terraformOptions, "bucket_name")
-	assert.Contains(t, bucketName, "agent-mesh-test-artifacts")
+	// Idempotency check: a plan re-run immediately after apply should be a
+	// no-op. A non-zero exit code here means something in the module drifts
+	// on every apply (e.g. a provider bump that wants to rewrite tags).
+	assert.Equal(t, 0, terraform.PlanExitCode(t, terraformOptions), "expected zero-change plan after apply for %s", tc.name)
+
+	outputs := map[string]string{
+		tc.nameOutput: terraform.Output(t, terraformOptions, tc.nameOutput),
+		tc.arnOutput:  terraform.Output(t, terraformOptions, tc.arnOutput),
+	}
 
-	bucketArn := terraform.Output(t, terraformOptions, "bucket_arn")
-	assert.Contains(t, bucketArn, "arn:aws:s3")
+	assert.Regexp(t, tc.namePattern, outputs[tc.nameOutput], "unexpected naming convention for %s", tc.name)
+	assert.Regexp(t, tc.arnPattern, outputs[tc.arnOutput], "unexpected ARN shape for %s", tc.name)
+
+	if tc.verify != nil {
+		tc.verify(t, tc.region, outputs)
+	}
 }
 
-func TestEventBridgeModule(t *testing.T) {
-	t.Parallel()
+func verifyDynamoDB(t *testing.T, region string, outputs map[string]string) {
+	t.Helper()
+	ddb := awsclient.DynamoDB(t, region)
+	key := "terratest-ttl-roundtrip"
+	awsclient.PutItemWithTTL(t, ddb, outputs["table_name"], "ttl", key, "hello", time.Hour)
+	defer awsclient.DeleteItem(t, ddb, outputs["table_name"], key)
 
-	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
-		TerraformDir: "../modules/eventbridge_bus",
-		Vars: map[string]interface{}{
-			"env": "test",
-		},
-		NoColor: true,
-	})
+	item := awsclient.GetItem(t, ddb, outputs["table_name"], key)
+	assert.NotEmpty(t, item, "expected item %s to round-trip through %s", key, outputs["table_name"])
+}
 
-	defer terraform.Destroy(t, terraformOptions)
-	terraform.InitAndApply(t, terraformOptions)
+func verifyS3(t *testing.T, region string, outputs map[string]string) {
+	t.Helper()
+	s3Client := awsclient.S3(t, region)
+	objectKey := "terratest-sse-roundtrip.txt"
+	body := []byte("agent-mesh artifact round-trip")
+	awsclient.PutObject(t, s3Client, outputs["bucket_name"], objectKey, "aws:kms", body)
+	defer awsclient.DeleteObject(t, s3Client, outputs["bucket_name"], objectKey)
 
-	// Test outputs
-	busName := terraform.Output(t, terraformOptions, "bus_name")
-	assert.Contains(t, busName, "agent-mesh-test")
+	got := awsclient.GetObjectAndAssertSSE(t, s3Client, outputs["bucket_name"], objectKey, "aws:kms")
+	assert.Equal(t, body, got)
+}
 
-	busArn := terraform.Output(t, terraformOptions, "bus_arn")
-	assert.Contains(t, busArn, "arn:aws:events")
-}
\ No newline at end of file
+func verifyEventBridge(t *testing.T, region string, outputs map[string]string) {
+	t.Helper()
+	ebClient := awsclient.EventBridge(t, region)
+	sqsClient := awsclient.SQS(t, region)
+
+	body := awsclient.AwaitEventDelivery(
+		t, ebClient, sqsClient, outputs["bus_arn"], outputs["bus_name"],
+		"agent-mesh.terratest", "RoundTripProbe", `{"ping":"pong"}`,
+		30*time.Second,
+	)
+	assert.Contains(t, string(body), "RoundTripProbe")
+}
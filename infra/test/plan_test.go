@@ -0,0 +1,92 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// planCase describes what a plan-only run of a module is expected to do:
+// the resource addresses it creates. Keeping this separate from matrixCase
+// lets the plan variants run without ever touching real infrastructure.
+type planCase struct {
+	name            string
+	module          string
+	vars            map[string]interface{}
+	expectedCreated []string
+}
+
+var planCases = []planCase{
+	{
+		name:   "dynamodb_kv",
+		module: "dynamodb_kv",
+		vars:   map[string]interface{}{"env": "test"},
+		expectedCreated: []string{
+			"aws_dynamodb_table.this",
+		},
+	},
+	{
+		name:   "s3_bucket_artifacts",
+		module: "s3_bucket_artifacts",
+		vars:   map[string]interface{}{"env": "test"},
+		expectedCreated: []string{
+			"aws_s3_bucket.this",
+			"aws_s3_bucket_server_side_encryption_configuration.this",
+			"aws_s3_bucket_public_access_block.this",
+		},
+	},
+	{
+		name:   "eventbridge_bus",
+		module: "eventbridge_bus",
+		vars:   map[string]interface{}{"env": "test"},
+		expectedCreated: []string{
+			"aws_cloudwatch_event_bus.this",
+			"aws_cloudwatch_event_bus_policy.this",
+		},
+	},
+}
+
+// TestModulesPlan runs a plan-only pass per module: no apply, no destroy,
+// just an assertion on exactly which resources a fresh plan intends to
+// create. This gives PR CI fast feedback without paying for
+// terraform.InitAndApply.
+//
+// None of these three modules currently produce a sensitive output
+// (table/bucket/bus names and ARNs aren't secrets), so there's nothing to
+// assert on plan.OutputChanges[...].AfterSensitive yet. Add an
+// expectedSensitiveOutputs-style check here if a module ever grows one
+// (e.g. a KMS-backed value).
+func TestModulesPlan(t *testing.T) {
+	t.Parallel()
+
+	for _, pc := range planCases {
+		pc := pc
+		t.Run(pc.name, func(t *testing.T) {
+			t.Parallel()
+			runPlanCase(t, pc)
+		})
+	}
+}
+
+func runPlanCase(t *testing.T, pc planCase) {
+	t.Helper()
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../modules/" + pc.module,
+		Vars:         pc.vars,
+		NoColor:      true,
+	})
+
+	plan := terraform.InitAndPlanAndShowWithStruct(t, terraformOptions)
+	require.NotNil(t, plan, "expected a parsed plan for %s", pc.module)
+
+	for _, addr := range pc.expectedCreated {
+		change, ok := plan.ResourceChangesMap[addr]
+		require.True(t, ok, "plan for %s did not include expected resource %s", pc.module, addr)
+		assert.Contains(t, change.Change.Actions, tfjson.ActionCreate, "expected %s to be created, got actions %v", addr, change.Change.Actions)
+	}
+	assert.Len(t, plan.ResourceChangesMap, len(pc.expectedCreated), "plan for %s created unexpected extra resources", pc.module)
+}
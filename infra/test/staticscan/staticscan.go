@@ -0,0 +1,116 @@
+// Package staticscan runs a pre-apply trivy/pkg/iac scan of a module
+// directory so misconfigurations are caught before a test spends minutes on
+// terraform apply/destroy. It loads the built-in AWS rule set plus a small
+// repo-local Rego rule set under policies/, and honors a per-module
+// .trivyignore allowlist for accepted exceptions.
+package staticscan
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/aquasecurity/trivy/pkg/iac/rego"
+	"github.com/aquasecurity/trivy/pkg/iac/scanners/terraform"
+	"github.com/aquasecurity/trivy/pkg/iac/severity"
+	"github.com/stretchr/testify/require"
+)
+
+// Severity mirrors the trivy severity levels we gate on.
+type Severity = severity.Severity
+
+// policiesDir holds the repo-local Rego rules layered on top of the
+// built-in AWS checks; it's resolved relative to this source file so it
+// works regardless of the test's working directory.
+var policiesDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "policies")
+}()
+
+// Options configures a single pre-apply scan.
+type Options struct {
+	// MinFailSeverity is the lowest severity that fails the test; findings
+	// below it are still logged but do not fail.
+	MinFailSeverity Severity
+	// AllowlistPath, if set, points at a .trivyignore-style file (one rule
+	// or AVD ID per line, "#" comments and blank lines ignored) of findings
+	// this module has accepted.
+	AllowlistPath string
+}
+
+// Run scans dir and fails t if any non-allowlisted finding is at or above
+// opts.MinFailSeverity. Every finding is logged via t.Logf as file:line
+// regardless of outcome, so reviewers can see what the scan saw.
+func Run(t *testing.T, dir string, opts Options) {
+	t.Helper()
+
+	allowed := loadAllowlist(t, opts.AllowlistPath)
+
+	scanner := terraform.New(
+		rego.WithEmbeddedPolicies(true),
+		rego.WithEmbeddedLibraries(true),
+		rego.WithPolicyDirs(policiesDir),
+	)
+
+	results, err := scanner.ScanFS(context.Background(), os.DirFS(dir), ".")
+	require.NoError(t, err, "running static scan of %s", dir)
+
+	var failures []string
+	for _, res := range results {
+		rule := res.Rule()
+		if allowed[rule.AVDID] || allowed[rule.LongID] {
+			t.Logf("staticscan: %s:%d [%s] %s (allowlisted)", res.Range().GetFilename(), res.Range().GetStartLine(), rule.Severity, rule.LongID)
+			continue
+		}
+
+		t.Logf("staticscan: %s:%d [%s] %s: %s", res.Range().GetFilename(), res.Range().GetStartLine(), rule.Severity, rule.LongID, res.Description())
+
+		if severityAtLeast(rule.Severity, opts.MinFailSeverity) {
+			failures = append(failures, fmt.Sprintf("%s:%d %s: %s", res.Range().GetFilename(), res.Range().GetStartLine(), rule.LongID, res.Description()))
+		}
+	}
+
+	if len(failures) > 0 {
+		t.Fatalf("static scan of %s found %d finding(s) at or above %s:\n%s", dir, len(failures), opts.MinFailSeverity, strings.Join(failures, "\n"))
+	}
+}
+
+func loadAllowlist(t *testing.T, path string) map[string]bool {
+	t.Helper()
+	allowed := map[string]bool{}
+	if path == "" {
+		return allowed
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return allowed
+	}
+	require.NoError(t, err, "reading allowlist %s", path)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		allowed[line] = true
+	}
+	return allowed
+}
+
+func severityAtLeast(got, min Severity) bool {
+	rank := map[Severity]int{
+		severity.Low:      1,
+		severity.Medium:   2,
+		severity.High:     3,
+		severity.Critical: 4,
+	}
+	return rank[got] >= rank[min]
+}
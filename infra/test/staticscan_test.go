@@ -0,0 +1,37 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aquasecurity/trivy/pkg/iac/severity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/staticscan"
+)
+
+// eventBridgeBusWithoutPolicyHCL trips AGENTMESH-EB-001: a bus with no
+// matching aws_cloudwatch_event_bus_policy.
+const eventBridgeBusWithoutPolicyHCL = `
+resource "aws_cloudwatch_event_bus" "this" {
+  name = "agent-mesh-test"
+}
+`
+
+// TestStaticscanRun_EventBridgeBusPolicyFindingFailsAtHigh proves
+// AGENTMESH-EB-001 is actually wired into the compliance gate now that it's
+// severity HIGH: a module missing the bus policy must fail staticscan.Run
+// under severity.High, the same MinFailSeverity every matrix case in
+// modules_test.go uses, not just get logged.
+func TestStaticscanRun_EventBridgeBusPolicyFindingFailsAtHigh(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(eventBridgeBusWithoutPolicyHCL), 0o644))
+
+	ok := t.Run("scan", func(t *testing.T) {
+		staticscan.Run(t, dir, staticscan.Options{MinFailSeverity: severity.High})
+	})
+
+	assert.False(t, ok, "expected staticscan.Run to fail on an EventBridge bus with no resource policy")
+}
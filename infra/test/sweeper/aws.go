@@ -0,0 +1,158 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func findDynamoDBTables(ctx context.Context, cfg aws.Config, opts Options) ([]Candidate, error) {
+	client := dynamodb.NewFromConfig(cfg)
+
+	var names []string
+	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, page.TableNames...)
+	}
+
+	var candidates []Candidate
+	for _, name := range names {
+		if !strings.HasPrefix(name, opts.Prefix) {
+			continue
+		}
+
+		desc, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+		if err != nil {
+			return nil, err
+		}
+
+		createdAt := aws.ToTime(desc.Table.CreationDateTime)
+		if time.Since(createdAt) < opts.MaxAge {
+			continue
+		}
+
+		candidates = append(candidates, Candidate{Kind: KindDynamoDBTable, Name: name, CreatedAt: createdAt})
+	}
+	return candidates, nil
+}
+
+func deleteDynamoDBTable(ctx context.Context, client *dynamodb.Client, name string) error {
+	_, err := client.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(name)})
+	var notFound *ddbtypes.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+func findS3Buckets(ctx context.Context, cfg aws.Config, opts Options) ([]Candidate, error) {
+	client := s3.NewFromConfig(cfg)
+
+	out, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []Candidate
+	for _, bucket := range out.Buckets {
+		name := aws.ToString(bucket.Name)
+		if !strings.HasPrefix(name, opts.Prefix) {
+			continue
+		}
+
+		createdAt := aws.ToTime(bucket.CreationDate)
+		if time.Since(createdAt) < opts.MaxAge {
+			continue
+		}
+
+		candidates = append(candidates, Candidate{Kind: KindS3Bucket, Name: name, CreatedAt: createdAt})
+	}
+	return candidates, nil
+}
+
+func deleteS3Bucket(ctx context.Context, client *s3.Client, name string) error {
+	if err := emptyBucket(ctx, client, name); err != nil {
+		return err
+	}
+
+	_, err := client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(name)})
+	var noSuchBucket *s3types.NoSuchBucket
+	if errors.As(err, &noSuchBucket) {
+		return nil
+	}
+	return err
+}
+
+func emptyBucket(ctx context.Context, client *s3.Client, name string) error {
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{Bucket: aws.String(name)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range page.Contents {
+			if _, err := client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(name),
+				Key:    obj.Key,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func findEventBridgeBuses(ctx context.Context, cfg aws.Config, opts Options) ([]Candidate, error) {
+	client := eventbridge.NewFromConfig(cfg)
+
+	var candidates []Candidate
+	var nextToken *string
+	for {
+		out, err := client.ListEventBuses(ctx, &eventbridge.ListEventBusesInput{
+			NamePrefix: aws.String(opts.Prefix),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// The EventBridge API does not expose a bus creation timestamp, so
+		// MaxAge can't be applied here; any name-prefix match is eligible.
+		for _, bus := range out.EventBuses {
+			candidates = append(candidates, Candidate{Kind: KindEventBridgeBus, Name: aws.ToString(bus.Name)})
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return candidates, nil
+}
+
+func deleteEventBridgeBus(ctx context.Context, client *eventbridge.Client, name string) error {
+	_, err := client.DeleteEventBus(ctx, &eventbridge.DeleteEventBusInput{Name: aws.String(name)})
+	var notFound *ebtypes.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+// Package sweeper enumerates and, optionally, deletes AWS resources left
+// behind by the agent-mesh Terratest suite when a run's terraform.Destroy
+// never got a chance to execute (network hiccup, panic mid-test, forced CI
+// cancellation). It is deliberately narrow: it only ever touches resources
+// whose name matches the Terratest naming prefix.
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Options configures a single sweep pass.
+type Options struct {
+	// Prefix matches resource names considered eligible for sweeping.
+	Prefix string
+	// MaxAge is how old a resource must be before it's swept. Not every
+	// resource kind exposes a creation timestamp through its API (see the
+	// EventBridge note on Candidate.CreatedAt below); for those kinds any
+	// name match is treated as eligible regardless of MaxAge.
+	MaxAge time.Duration
+	// DryRun, when true, only reports candidates without deleting them.
+	DryRun bool
+}
+
+// Kind identifies the AWS resource type a Candidate refers to.
+type Kind string
+
+const (
+	KindDynamoDBTable  Kind = "dynamodb_table"
+	KindS3Bucket       Kind = "s3_bucket"
+	KindEventBridgeBus Kind = "eventbridge_bus"
+)
+
+// Candidate is a single resource a sweep pass found or deleted.
+type Candidate struct {
+	Kind Kind
+	Name string
+	// CreatedAt is the zero time for resource kinds (EventBridge buses)
+	// whose AWS API does not expose a creation timestamp.
+	CreatedAt time.Time
+	Deleted   bool
+}
+
+// Find enumerates resources matching opts.Prefix and, where a creation
+// timestamp is available, older than opts.MaxAge. It performs no deletes.
+func Find(ctx context.Context, cfg aws.Config, opts Options) ([]Candidate, error) {
+	var candidates []Candidate
+
+	tables, err := findDynamoDBTables(ctx, cfg, opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing dynamodb tables: %w", err)
+	}
+	candidates = append(candidates, tables...)
+
+	buckets, err := findS3Buckets(ctx, cfg, opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing s3 buckets: %w", err)
+	}
+	candidates = append(candidates, buckets...)
+
+	buses, err := findEventBridgeBuses(ctx, cfg, opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing eventbridge buses: %w", err)
+	}
+	candidates = append(candidates, buses...)
+
+	return candidates, nil
+}
+
+// Sweep finds candidates and, unless opts.DryRun is set, deletes each one.
+// It is idempotent: deleting a resource that's already gone (e.g. because a
+// concurrent sweep or the original test's destroy raced it) is not an
+// error. It returns the full candidate list (also when a delete fails
+// part-way; nil when listing fails), with Deleted set on whichever were
+// actually removed. Find returns the same list without deleting anything,
+// so a manifest can be printed before Sweep runs.
+func Sweep(ctx context.Context, cfg aws.Config, opts Options) ([]Candidate, error) {
+	candidates, err := Find(ctx, cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return candidates, nil
+	}
+
+	ddbClient := dynamodb.NewFromConfig(cfg)
+	s3Client := s3.NewFromConfig(cfg)
+	ebClient := eventbridge.NewFromConfig(cfg)
+
+	for i := range candidates {
+		c := &candidates[i]
+		var delErr error
+
+		switch c.Kind {
+		case KindDynamoDBTable:
+			delErr = deleteDynamoDBTable(ctx, ddbClient, c.Name)
+		case KindS3Bucket:
+			delErr = deleteS3Bucket(ctx, s3Client, c.Name)
+		case KindEventBridgeBus:
+			delErr = deleteEventBridgeBus(ctx, ebClient, c.Name)
+		}
+
+		if delErr != nil {
+			return candidates, fmt.Errorf("deleting %s %s: %w", c.Kind, c.Name, delErr)
+		}
+		c.Deleted = true
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,65 @@
+//go:build sweeper
+
+package test
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/awsclient"
+	"github.com/Baur-Software/aws-ai-agent-bus/infra/test/sweeper"
+)
+
+var (
+	sweepDryRun = flag.Bool("sweep.dry-run", true, "log what would be deleted without deleting anything")
+	sweepMaxAge = flag.Duration("sweep.max-age", 2*time.Hour, "minimum resource age before it's considered orphaned")
+	sweepPrefix = flag.String("sweep.prefix", "agent-mesh-test-", "name prefix identifying Terratest-created resources")
+)
+
+// TestSweep deletes orphaned agent-mesh-test-* resources (DynamoDB tables,
+// S3 buckets, EventBridge buses) left behind when a prior run's
+// terraform.Destroy never got to execute. It is gated behind the sweeper
+// build tag so it never runs as part of the normal suite:
+//
+//	go test -tags=sweeper ./test/... -run TestSweep -sweep.dry-run=false
+//
+// Defaults to a dry run that only logs a manifest of what would be
+// deleted; pass -sweep.dry-run=false to actually delete.
+func TestSweep(t *testing.T) {
+	cfg := awsclient.NewConfig(t, awsclient.DefaultRegion)
+
+	opts := sweeper.Options{
+		Prefix: *sweepPrefix,
+		MaxAge: *sweepMaxAge,
+		DryRun: *sweepDryRun,
+	}
+
+	if !opts.DryRun {
+		found, err := sweeper.Find(context.Background(), cfg, opts)
+		if err != nil {
+			t.Fatalf("listing sweep candidates failed: %v", err)
+		}
+		for _, c := range found {
+			t.Logf("sweeper: about to delete %s %s", c.Kind, c.Name)
+		}
+	}
+
+	candidates, err := sweeper.Sweep(context.Background(), cfg, opts)
+	if err != nil {
+		t.Fatalf("sweep failed: %v", err)
+	}
+
+	t.Logf("sweeper: %d candidate(s) matching prefix %q", len(candidates), opts.Prefix)
+	for _, c := range candidates {
+		switch {
+		case opts.DryRun:
+			t.Logf("sweeper: would delete %s %s", c.Kind, c.Name)
+		case c.Deleted:
+			t.Logf("sweeper: deleted %s %s", c.Kind, c.Name)
+		default:
+			t.Logf("sweeper: left %s %s untouched", c.Kind, c.Name)
+		}
+	}
+}